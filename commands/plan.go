@@ -0,0 +1,232 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/DNSControl/dnscontrol/v4/models"
+	"github.com/DNSControl/dnscontrol/v4/pkg/printer"
+	"github.com/DNSControl/dnscontrol/v4/pkg/version"
+)
+
+// planFormatVersion is incremented whenever the plan file format
+// changes in a way that older versions of DNSControl can't read.
+const planFormatVersion = 1
+
+// Plan is a saved copy of the corrections that "preview --out-plan"
+// identified. "push --plan" refuses to run unless it computes exactly
+// the same corrections from exactly the same existing records.
+type Plan struct {
+	FormatVersion int         `json:"format_version"`
+	Version       string      `json:"dnscontrol_version"`
+	Created       time.Time   `json:"created"`
+	Domains       string      `json:"domains,omitempty"`   // The --domains filter used to create the plan.
+	Providers     string      `json:"providers,omitempty"` // The --providers filter used to create the plan.
+	Items         []*PlanItem `json:"items"`
+}
+
+// PlanItem is the planned work for a particular domain/provider/registrar.
+type PlanItem struct {
+	Domain    string `json:"domain"`
+	Provider  string `json:"provider,omitempty"`
+	Registrar string `json:"registrar,omitempty"`
+
+	// ExistingFingerprint is a hash of the records that GetZoneRecords
+	// returned when the plan was made. It is blank for registrars.
+	ExistingFingerprint string `json:"existing_fingerprint,omitempty"`
+	// DesiredFingerprint is a hash of the records in dnsconfig.js.
+	DesiredFingerprint string `json:"desired_fingerprint"`
+
+	Populate    []string `json:"populate,omitempty"`    // Zone creations.
+	Corrections []string `json:"corrections,omitempty"` // Everything else.
+}
+
+// key returns a string that uniquely identifies the item within a plan.
+func (pi *PlanItem) key() string {
+	if pi.Registrar != "" {
+		return pi.Domain + " registrar " + pi.Registrar
+	}
+	return pi.Domain + " provider " + pi.Provider
+}
+
+// fingerprintRecords returns a hash of the records that is independent
+// of the order they were returned by the provider.
+func fingerprintRecords(recs models.Records) string {
+	lines := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		lines = append(lines, fmt.Sprintf("%s %d %s %s", rec.NameFQDN, rec.TTL, rec.Type, rec.ToComparableNoTTL()))
+	}
+	slices.Sort(lines)
+
+	h := sha256.New()
+	for _, l := range lines {
+		h.Write([]byte(l))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// correctionLines returns the (uncolored) text of the corrections that
+// would be executed, skipping informational messages.
+func correctionLines(corrections []*models.Correction) []string {
+	var lines []string
+	for _, cor := range corrections {
+		if cor.F != nil {
+			lines = append(lines, parseCorrectionMsg(cor.Msg)...)
+		}
+	}
+	return lines
+}
+
+// genPlanItem records the work that is pending for a DNS provider.
+func genPlanItem(zone *models.DomainConfig, providerName string) *PlanItem {
+	pi := &PlanItem{
+		Domain:             zone.GetUniqueName(),
+		Provider:           providerName,
+		DesiredFingerprint: fingerprintRecords(zone.Records),
+		Populate:           correctionLines(zone.GetPopulateCorrections(providerName)),
+		Corrections:        correctionLines(zone.GetCorrections(providerName)),
+	}
+	if existing, ok := zone.GetExistingRecords(providerName); ok {
+		pi.ExistingFingerprint = fingerprintRecords(existing)
+	}
+	return pi
+}
+
+// genPlanRegistrarItem records the work that is pending for a registrar.
+func genPlanRegistrarItem(zone *models.DomainConfig) *PlanItem {
+	return &PlanItem{
+		Domain:             zone.GetUniqueName(),
+		Registrar:          zone.RegistrarInstance.Name,
+		DesiredFingerprint: fingerprintRecords(zone.Records),
+		Corrections:        correctionLines(zone.GetCorrections(zone.RegistrarInstance.Name)),
+	}
+}
+
+// newPlan returns an empty plan that records the filters that were used.
+func newPlan(args PPreviewArgs) *Plan {
+	return &Plan{
+		FormatVersion: planFormatVersion,
+		Version:       version.Version(),
+		Created:       time.Now().UTC(),
+		Domains:       args.Domains,
+		Providers:     args.Providers,
+	}
+}
+
+// writePlan writes the plan to filename as JSON.
+func writePlan(filename string, plan *Plan) error {
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(plan)
+}
+
+// readPlan reads a plan that was written by writePlan.
+func readPlan(filename string) (*Plan, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	plan := &Plan{}
+	if err := json.NewDecoder(f).Decode(plan); err != nil {
+		return nil, fmt.Errorf("reading plan %q: %w", filename, err)
+	}
+	if plan.FormatVersion != planFormatVersion {
+		return nil, fmt.Errorf("plan %q has format version %d but this version of dnscontrol requires %d", filename, plan.FormatVersion, planFormatVersion)
+	}
+	return plan, nil
+}
+
+// comparePlan compares the work that is pending now with the work
+// that was saved in the plan. It returns a description of each
+// difference. An empty list means the plan can be executed as-is.
+// If populateOnly is true, only the zone creations are compared.
+func comparePlan(saved []*PlanItem, current []*PlanItem, populateOnly bool) []string {
+	var diffs []string
+
+	savedByKey := map[string]*PlanItem{}
+	for _, pi := range saved {
+		savedByKey[pi.key()] = pi
+	}
+
+	seen := map[string]bool{}
+	for _, cur := range current {
+		k := cur.key()
+		seen[k] = true
+		old, ok := savedByKey[k]
+		if !ok {
+			if len(cur.Populate) != 0 || (!populateOnly && len(cur.Corrections) != 0) {
+				diffs = append(diffs, fmt.Sprintf("%s: has changes that are not in the plan", k))
+			}
+			continue
+		}
+		if !slices.Equal(old.Populate, cur.Populate) {
+			diffs = append(diffs, fmt.Sprintf("%s: zone creation differs from the plan", k))
+		}
+		if populateOnly {
+			continue
+		}
+		if old.DesiredFingerprint != cur.DesiredFingerprint {
+			diffs = append(diffs, fmt.Sprintf("%s: dnsconfig.js has changed since the plan was made", k))
+		}
+		if old.ExistingFingerprint != cur.ExistingFingerprint {
+			diffs = append(diffs, fmt.Sprintf("%s: the live zone has changed since the plan was made", k))
+		}
+		if !slices.Equal(old.Corrections, cur.Corrections) {
+			diffs = append(diffs, fmt.Sprintf("%s: corrections differ from the plan", k))
+		}
+	}
+
+	if populateOnly {
+		return diffs
+	}
+	for _, pi := range saved {
+		k := pi.key()
+		if !seen[k] && (len(pi.Populate) != 0 || len(pi.Corrections) != 0) {
+			diffs = append(diffs, fmt.Sprintf("%s: is in the plan but was not processed", k))
+		}
+	}
+	return diffs
+}
+
+// genPlanItems records the work that is pending for the zones, in the
+// same order that prun() processes them.
+func genPlanItems(zones []*models.DomainConfig, providerFilter string) []*PlanItem {
+	var items []*PlanItem
+	for _, zone := range zones {
+		providersToProcess := whichProvidersToProcess(zone.DNSProviderInstances, providerFilter)
+		for _, provider := range providersToProcess {
+			items = append(items, genPlanItem(zone, provider.Name))
+		}
+		if skipProvider(zone.RegistrarInstance.Name, providersToProcess) {
+			items = append(items, genPlanRegistrarItem(zone))
+		}
+	}
+	return items
+}
+
+// verifyPlan returns an error if the pending work differs from the plan.
+func verifyPlan(plan *Plan, zones []*models.DomainConfig, providerFilter string, populateOnly bool) error {
+	diffs := comparePlan(plan.Items, genPlanItems(zones, providerFilter), populateOnly)
+	if len(diffs) == 0 {
+		return nil
+	}
+	for _, d := range diffs {
+		printer.Printf("PLAN MISMATCH: %s\n", d)
+	}
+	return fmt.Errorf("refusing to push: %d difference(s) from the plan. Run preview again to create a new plan", len(diffs))
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/DNSControl/dnscontrol/v4/models"
+)
+
+func makePlanRC(label, target string, ttl uint32) *models.RecordConfig {
+	rc := &models.RecordConfig{Type: "A", TTL: ttl}
+	rc.SetLabel(label, "example.com")
+	rc.MustSetTarget(target)
+	return rc
+}
+
+func Test_fingerprintRecords(t *testing.T) {
+	a := makePlanRC("www", "1.2.3.4", 300)
+	b := makePlanRC("@", "5.6.7.8", 300)
+	bTTL := makePlanRC("@", "5.6.7.8", 600)
+
+	if fingerprintRecords(models.Records{a, b}) != fingerprintRecords(models.Records{b, a}) {
+		t.Errorf("fingerprint should not depend on the order of the records")
+	}
+	if fingerprintRecords(models.Records{a, b}) == fingerprintRecords(models.Records{a, bTTL}) {
+		t.Errorf("fingerprint should change when a TTL changes")
+	}
+	if fingerprintRecords(models.Records{a, b}) == fingerprintRecords(models.Records{a}) {
+		t.Errorf("fingerprint should change when a record is removed")
+	}
+}
+
+func Test_comparePlan(t *testing.T) {
+	item := func(provider, existing string, corrections ...string) *PlanItem {
+		return &PlanItem{
+			Domain:              "example.com",
+			Provider:            provider,
+			ExistingFingerprint: existing,
+			DesiredFingerprint:  "desired",
+			Corrections:         corrections,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		saved        []*PlanItem
+		current      []*PlanItem
+		populateOnly bool
+		wantDiffs    int
+	}{
+		{
+			name:      "identical",
+			saved:     []*PlanItem{item("p1", "aaa", "+ CREATE A www 1.2.3.4")},
+			current:   []*PlanItem{item("p1", "aaa", "+ CREATE A www 1.2.3.4")},
+			wantDiffs: 0,
+		},
+		{
+			name:      "liveZoneChanged",
+			saved:     []*PlanItem{item("p1", "aaa", "+ CREATE A www 1.2.3.4")},
+			current:   []*PlanItem{item("p1", "bbb", "+ CREATE A www 1.2.3.4")},
+			wantDiffs: 1,
+		},
+		{
+			name:      "correctionsChanged",
+			saved:     []*PlanItem{item("p1", "aaa", "+ CREATE A www 1.2.3.4")},
+			current:   []*PlanItem{item("p1", "aaa", "+ CREATE A www 5.6.7.8")},
+			wantDiffs: 1,
+		},
+		{
+			name:      "providerNotInPlan",
+			saved:     []*PlanItem{item("p1", "aaa")},
+			current:   []*PlanItem{item("p1", "aaa"), item("p2", "aaa", "+ CREATE A www 1.2.3.4")},
+			wantDiffs: 1,
+		},
+		{
+			name:      "providerNoLongerProcessed",
+			saved:     []*PlanItem{item("p1", "aaa"), item("p2", "aaa", "+ CREATE A www 1.2.3.4")},
+			current:   []*PlanItem{item("p1", "aaa")},
+			wantDiffs: 1,
+		},
+		{
+			name:         "populateOnlyIgnoresRecords",
+			saved:        []*PlanItem{item("p1", "aaa", "+ CREATE A www 1.2.3.4")},
+			current:      []*PlanItem{item("p1", "", "")},
+			populateOnly: true,
+			wantDiffs:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := comparePlan(tt.saved, tt.current, tt.populateOnly)
+			if len(got) != tt.wantDiffs {
+				t.Errorf("comparePlan() = %v, want %d difference(s)", got, tt.wantDiffs)
+			}
+		})
+	}
+}
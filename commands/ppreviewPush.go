@@ -50,7 +50,11 @@ var _ = cmd(catMain, func() *cli.Command {
 		Action: func(ctx context.Context, c *cli.Command) error {
 			return exit(PPreview(args))
 		},
-		Flags: args.flags(),
+		Flags: append(args.flags(), &cli.StringFlag{
+			Name:        "out-plan",
+			Destination: &args.OutPlan,
+			Usage:       `Save the corrections to this file so that "push --plan" can run exactly them`,
+		}),
 	}
 }())
 
@@ -67,6 +71,8 @@ type PPreviewArgs struct {
 	PopulateOnPreview bool
 	Report            string
 	Full              bool
+	OutPlan           string // Write a plan to this file (preview only)
+	Plan              string // Execute the plan in this file (push only)
 }
 
 // ReportItem is a record of corrections for a particular domain/provider/registrar.
@@ -180,6 +186,11 @@ func (args *PPushArgs) flags() []cli.Flag {
 		Destination: &args.Interactive,
 		Usage:       "Interactive. Confirm or Exclude each correction before they run",
 	})
+	flags = append(flags, &cli.StringFlag{
+		Name:        "plan",
+		Destination: &args.Plan,
+		Usage:       `Run the corrections saved by "preview --out-plan". Refuse if anything has changed since then`,
+	})
 	return flags
 }
 
@@ -217,6 +228,18 @@ func prun(args PPreviewArgs, push bool, interactive bool, out printer.CLI, repor
 		return err
 	}
 
+	var savedPlan *Plan
+	if args.Plan != "" {
+		savedPlan, err = readPlan(args.Plan)
+		if err != nil {
+			return err
+		}
+		// Default to the same filters that were used to create the plan.
+		args.Domains = cmp.Or(args.Domains, savedPlan.Domains)
+		args.Providers = cmp.Or(args.Providers, savedPlan.Providers)
+		out.PrintfIf(fullMode, "Using plan %q created %s\n", args.Plan, savedPlan.Created.Format(time.RFC3339))
+	}
+
 	var notify = args.Notify
 
 	// We want to notify if args.Notify OR notify_on_*
@@ -309,6 +332,12 @@ func prun(args PPreviewArgs, push bool, interactive bool, out printer.CLI, repor
 			out.PrintfIf(true, "DONE\n")
 		}
 
+		if savedPlan != nil {
+			if err := verifyPlan(savedPlan, zonesToProcess, args.Providers, true); err != nil {
+				return err
+			}
+		}
+
 		for _, zone := range zonesToProcess {
 			started := false // Do not emit noise when no provider has corrections.
 			providersToProcess := whichProvidersToProcess(zone.DNSProviderInstances, args.Providers)
@@ -378,6 +407,20 @@ func prun(args PPreviewArgs, push bool, interactive bool, out printer.CLI, repor
 
 	anyErrors = cmp.Or(anyErrors, concurrentErrors.Load())
 
+	if savedPlan != nil {
+		if err := verifyPlan(savedPlan, zonesToProcess, args.Providers, false); err != nil {
+			return err
+		}
+	}
+	if args.OutPlan != "" {
+		plan := newPlan(args)
+		plan.Items = genPlanItems(zonesToProcess, args.Providers)
+		if err := writePlan(args.OutPlan, plan); err != nil {
+			return fmt.Errorf("could not write plan: %w", err)
+		}
+		out.PrintfIf(fullMode, "Plan written to %q\n", args.OutPlan)
+	}
+
 	// Now we know what to do, print or do the tasks.
 	out.PrintfIf(fullMode, "PHASE 3: CORRECTIONS\n")
 	for _, zone := range zonesToProcess {
@@ -648,6 +691,7 @@ func parseCorrectionMsg(s string) []string {
 
 func genReportItem(zoneName string, corrections []*models.Correction, providerName string, registrarName string) *ReportItem {
 	correctionDetails := make([]string, 0)
+	var changes []*ReportChange
 	for _, cor := range corrections {
 		if cor.F != nil {
 			// `corrections` is a list that contains "informational" messages
@@ -661,6 +705,11 @@ func genReportItem(zoneName string, corrections []*models.Correction, providerNa
 			// number of corrections (`len(correctionDetails)`).
 
 			correctionDetails = append(correctionDetails, parseCorrectionMsg(cor.Msg)...)
+
+			// If the correction has a structured description, include it too.
+			for _, cc := range cor.Changes {
+				changes = append(changes, genReportChange(cc))
+			}
 		}
 	}
 
@@ -668,6 +717,7 @@ func genReportItem(zoneName string, corrections []*models.Correction, providerNa
 		Domain:            zoneName,
 		Corrections:       len(correctionDetails),
 		CorrectionDetails: correctionDetails,
+		Changes:           changes,
 	}
 	if providerName != "" {
 		r.Provider = providerName
@@ -710,7 +760,7 @@ func pprintOrRunCorrections(zoneName string, providerName string, corrections []
 					anyErrors = true
 				}
 			}
-			notifyErr := notifier.Notify(zoneName, providerName, correction.Msg, err, !push)
+			notifyErr := notifications.NotifyCorrection(notifier, zoneName, providerName, correction, err, !push)
 			if notifyErr != nil {
 				out.Warnf("Error sending notification: %s\n", notifyErr)
 			}
@@ -775,7 +825,12 @@ func generatePopulateCorrections(provider *models.DNSProviderInstance, zone *mod
 }
 
 func generateZoneCorrections(zone *models.DomainConfig, provider *models.DNSProviderInstance) ([]*models.Correction, []*models.Correction, int, error) {
-	reports, zoneCorrections, actualChangeCount, err := zonerecs.CorrectZoneRecords(provider.Driver, zone)
+	existing, err := zonerecs.GetZoneRecords(provider.Driver, zone)
+	if err != nil {
+		return []*models.Correction{{Msg: fmt.Sprintf("Domain %q provider %s Error: %s", zone.Name, provider.Name, err)}}, nil, 0, err
+	}
+	reports, zoneCorrections, actualChangeCount, err := zonerecs.CorrectExistingRecords(provider.Driver, zone, existing)
+	zone.StoreExistingRecords(provider.Name, existing)
 	if err != nil {
 		return []*models.Correction{{Msg: fmt.Sprintf("Domain %q provider %s Error: %s", zone.Name, provider.Name, err)}}, nil, 0, err
 	}
@@ -12,11 +12,47 @@ import (
 
 // ReportItem is a record of corrections for a particular domain/provider/registrar.
 type ReportItem struct {
-	Domain            string   `json:"domain"`
-	Corrections       int      `json:"corrections"`
-	CorrectionDetails []string `json:"correction_details,omitempty"`
-	Provider          string   `json:"provider,omitempty"`
-	Registrar         string   `json:"registrar,omitempty"`
+	Domain            string          `json:"domain"`
+	Corrections       int             `json:"corrections"`
+	CorrectionDetails []string        `json:"correction_details,omitempty"`
+	Changes           []*ReportChange `json:"changes,omitempty"`
+	Provider          string          `json:"provider,omitempty"`
+	Registrar         string          `json:"registrar,omitempty"`
+}
+
+// ReportChange is a record-level change within a ReportItem.
+type ReportChange struct {
+	Verb    string          `json:"verb"`
+	Name    string          `json:"name"`
+	Type    string          `json:"type"`
+	Old     []*ReportRecord `json:"old,omitempty"`
+	New     []*ReportRecord `json:"new,omitempty"`
+	OnlyTTL bool            `json:"only_ttl,omitempty"`
+}
+
+// ReportRecord is a single DNS record within a ReportChange.
+type ReportRecord struct {
+	TTL   uint32 `json:"ttl"`
+	Value string `json:"value"`
+}
+
+func genReportChange(cc *models.CorrectionChange) *ReportChange {
+	return &ReportChange{
+		Verb:    cc.Verb,
+		Name:    cc.Key.NameFQDN,
+		Type:    cc.Key.Type,
+		Old:     genReportRecords(cc.Old),
+		New:     genReportRecords(cc.New),
+		OnlyTTL: cc.HintOnlyTTL,
+	}
+}
+
+func genReportRecords(recs models.Records) []*ReportRecord {
+	var rr []*ReportRecord
+	for _, rec := range recs {
+		rr = append(rr, &ReportRecord{TTL: rec.TTL, Value: rec.ToComparableNoTTL()})
+	}
+	return rr
 }
 
 // InitializeProviders takes (fully processed) configuration and instantiates all providers and returns them.
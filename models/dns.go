@@ -103,6 +103,20 @@ func NameserversToStrings(nss []*Nameserver) (s []string) {
 type Correction struct {
 	F   func() error `json:"-"`
 	Msg string
+
+	// Changes is a structured description of what F does. It is filled
+	// in by diff2.Change.CreateCorrection() and friends. It is empty if
+	// the provider generated the Correction some other way.
+	Changes []*CorrectionChange `json:"changes,omitempty"`
+}
+
+// CorrectionChange describes one record-level change made by a Correction.
+type CorrectionChange struct {
+	Verb        string    `json:"verb"` // CREATE, CHANGE, or DELETE
+	Key         RecordKey `json:"key"`
+	Old         Records   `json:"old,omitempty"`
+	New         Records   `json:"new,omitempty"`
+	HintOnlyTTL bool      `json:"hint_only_ttl,omitempty"` // Only the TTL changed.
 }
 
 // PostProcess performs and post-processing required after running dnsconfig.js and loading the result.
@@ -69,6 +69,7 @@ type DomainConfig struct {
 	pendingCorrectionsOrder    []string                 // Call the providers in this order
 	pendingActualChangeCount   map[string]int           // Number of changes to report (cumulative)
 	pendingPopulateCorrections map[string][]*Correction // Corrections for zone creations at each provider
+	pendingExistingRecords     map[string]Records       // Records found at each provider (as returned by GetZoneRecords)
 }
 
 // PostProcess performs and post-processing required after running dnsconfig.js and loading the result.
@@ -239,6 +240,26 @@ func (dc *DomainConfig) GetPopulateCorrections(providerName string) []*Correctio
 	return dc.pendingPopulateCorrections[providerName]
 }
 
+// StoreExistingRecords saves the records found at a provider in a thread-safe way.
+func (dc *DomainConfig) StoreExistingRecords(providerName string, recs Records) {
+	dc.pendingCorrectionsMutex.Lock()
+	defer dc.pendingCorrectionsMutex.Unlock()
+
+	if dc.pendingExistingRecords == nil {
+		dc.pendingExistingRecords = make(map[string]Records, 1)
+	}
+	dc.pendingExistingRecords[providerName] = recs
+}
+
+// GetExistingRecords returns the records found at a provider in a thread-safe way.
+// The second return value is false if no records were stored for providerName.
+func (dc *DomainConfig) GetExistingRecords(providerName string) (Records, bool) {
+	dc.pendingCorrectionsMutex.Lock()
+	defer dc.pendingCorrectionsMutex.Unlock()
+	recs, ok := dc.pendingExistingRecords[providerName]
+	return recs, ok
+}
+
 // DomainNameVarieties returns the domain's names in various forms.
 func (dc *DomainConfig) DomainNameVarieties() *domaintags.DomainNameVarieties {
 	return &domaintags.DomainNameVarieties{
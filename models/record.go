@@ -507,8 +507,8 @@ func (rc *RecordConfig) GetDependencies() []string {
 
 // RecordKey represents a resource record in a format used by some systems.
 type RecordKey struct {
-	NameFQDN string
-	Type     string
+	NameFQDN string `json:"name"`
+	Type     string `json:"type"`
 }
 
 func (rk *RecordKey) String() string {
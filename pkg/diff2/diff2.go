@@ -114,9 +114,31 @@ Identify which your provider is, and use the appropriate "By*" call. (ByZone is
 // function and prefills it with the Msg of the current Change.
 func (c *Change) CreateCorrection(correctionFunction func() error) *models.Correction {
 	return &models.Correction{
-		F:   correctionFunction,
-		Msg: c.MsgsJoined,
+		F:       correctionFunction,
+		Msg:     c.MsgsJoined,
+		Changes: []*models.CorrectionChange{c.CorrectionChange()},
+	}
+}
+
+// CorrectionChange returns a structured description of the Change,
+// suitable for models.Correction.Changes.
+func (c *Change) CorrectionChange() *models.CorrectionChange {
+	cc := &models.CorrectionChange{
+		Verb:        c.Type.String(),
+		Key:         c.Key,
+		Old:         c.Old,
+		New:         c.New,
+		HintOnlyTTL: c.HintOnlyTTL,
+	}
+	// .Key.Type is only set by ByRecordSet. Fill it in for the others.
+	if cc.Key.Type == "" {
+		if len(c.New) != 0 {
+			cc.Key.Type = c.New[0].Type
+		} else if len(c.Old) != 0 {
+			cc.Key.Type = c.Old[0].Type
+		}
 	}
+	return cc
 }
 
 // CreateMessage creates a new correction with only the message.
@@ -132,8 +154,9 @@ func (c *Change) CreateMessage() *models.Correction {
 // current change.
 func (c *Change) CreateCorrectionWithMessage(msg string, correctionFunction func() error) *models.Correction {
 	return &models.Correction{
-		F:   correctionFunction,
-		Msg: fmt.Sprintf("%s: %s", msg, c.MsgsJoined),
+		F:       correctionFunction,
+		Msg:     fmt.Sprintf("%s: %s", msg, c.MsgsJoined),
+		Changes: []*models.CorrectionChange{c.CorrectionChange()},
 	}
 }
 
@@ -1,6 +1,10 @@
 package notifications
 
-import "regexp"
+import (
+	"regexp"
+
+	"github.com/DNSControl/dnscontrol/v4/models"
+)
 
 // Notifier is a type that can send a notification.
 type Notifier interface {
@@ -13,6 +17,24 @@ type Notifier interface {
 	Done()
 }
 
+// CorrectionNotifier is an optional interface for notifiers that want
+// the structured description of the correction (Correction.Changes)
+// and not just its message.
+type CorrectionNotifier interface {
+	// NotifyCorrection is like Notify but is given the entire correction.
+	NotifyCorrection(domain, provider string, correction *models.Correction, err error, preview bool) error
+}
+
+// NotifyCorrection sends a correction to n. If n implements
+// CorrectionNotifier it is given the entire correction, otherwise
+// just the correction's message.
+func NotifyCorrection(n Notifier, domain, provider string, correction *models.Correction, err error, preview bool) error {
+	if cn, ok := n.(CorrectionNotifier); ok {
+		return cn.NotifyCorrection(domain, provider, correction, err, preview)
+	}
+	return n.Notify(domain, provider, correction.Msg, err, preview)
+}
+
 // new notification types should add themselves to this array.
 var initers = []func(map[string]string) Notifier{}
 
@@ -58,6 +80,23 @@ func (m multiNotifier) Notify(domain, provider string, message string, err error
 	return nil
 }
 
+func (m multiNotifier) NotifyCorrection(domain, provider string, correction *models.Correction, err error, preview bool) error {
+	// See the comment in Notify() about removing ansi colors.
+	nCorrection := *correction
+	nCorrection.Msg = stripAnsiColors(correction.Msg)
+	errs := make([]error, 0)
+	for _, n := range m {
+		err := NotifyCorrection(n, domain, provider, &nCorrection, err, preview)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
 func (m multiNotifier) Done() {
 	for _, n := range m {
 		n.Done()
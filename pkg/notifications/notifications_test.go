@@ -1,6 +1,10 @@
 package notifications
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/DNSControl/dnscontrol/v4/models"
+)
 
 func Test_stripAnsiColorsValid(t *testing.T) {
 	coloredStr := "\x1b[0133myellow\x1b[0m" // 33 == yellow
@@ -21,3 +25,48 @@ func Test_stripAnsiColorsInvalid(t *testing.T) {
 		t.Errorf("stripAnsiColors() stripped %q should be different from %q", coloredStr, nonColoredStr)
 	}
 }
+
+type fakeNotifier struct {
+	msgs        []string
+	corrections []*models.Correction
+}
+
+func (f *fakeNotifier) Notify(domain, provider string, message string, err error, preview bool) error {
+	f.msgs = append(f.msgs, message)
+	return nil
+}
+
+func (f *fakeNotifier) Done() {}
+
+type fakeCorrectionNotifier struct {
+	fakeNotifier
+}
+
+func (f *fakeCorrectionNotifier) NotifyCorrection(domain, provider string, correction *models.Correction, err error, preview bool) error {
+	f.corrections = append(f.corrections, correction)
+	return nil
+}
+
+func Test_NotifyCorrection(t *testing.T) {
+	plain := &fakeNotifier{}
+	structured := &fakeCorrectionNotifier{}
+	m := multiNotifier{plain, structured}
+
+	c := &models.Correction{
+		Msg:     "\x1b[0133m+ CREATE foo.example.com A 1.2.3.4\x1b[0m",
+		Changes: []*models.CorrectionChange{{Verb: "CREATE"}},
+	}
+	if err := NotifyCorrection(m, "example.com", "prov", c, nil, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plain.msgs) != 1 || plain.msgs[0] != "+ CREATE foo.example.com A 1.2.3.4" {
+		t.Errorf("plain notifier got %q, want the uncolored message", plain.msgs)
+	}
+	if len(structured.corrections) != 1 || len(structured.corrections[0].Changes) != 1 {
+		t.Errorf("structured notifier did not receive the correction's changes")
+	}
+	if len(structured.msgs) != 0 {
+		t.Errorf("structured notifier should not receive Notify() calls, got %q", structured.msgs)
+	}
+}
@@ -1,7 +1,10 @@
 package zonerecs
 
 import (
+	"strings"
+
 	"github.com/DNSControl/dnscontrol/v4/models"
+	"github.com/DNSControl/dnscontrol/v4/pkg/diff2"
 	"github.com/DNSControl/dnscontrol/v4/pkg/rtypecontrol"
 )
 
@@ -9,12 +12,28 @@ import (
 // post-processing, and then calls GetZoneRecordsCorrections.  The
 // name sucks because all the good names were taken.
 func CorrectZoneRecords(driver models.DNSProvider, dc *models.DomainConfig) ([]*models.Correction, []*models.Correction, int, error) {
-	existingRecords, err := driver.GetZoneRecords(dc)
+	existingRecords, err := GetZoneRecords(driver, dc)
 	if err != nil {
 		return nil, nil, 0, err
 	}
+	return CorrectExistingRecords(driver, dc, existingRecords)
+}
+
+// GetZoneRecords calls the provider's GetZoneRecords and fixes up the
+// result so that it is ready to be handed to CorrectExistingRecords.
+func GetZoneRecords(driver models.DNSProvider, dc *models.DomainConfig) (models.Records, error) {
+	existingRecords, err := driver.GetZoneRecords(dc)
+	if err != nil {
+		return nil, err
+	}
 	rtypecontrol.FixLegacyRecords(&existingRecords) // Call this after GetZoneRecords() to fix providers that haven't been updated for RecordConfigV2.
+	return existingRecords, nil
+}
 
+// CorrectExistingRecords is the second half of CorrectZoneRecords. It
+// is useful when the caller needs to see (or save) the existing
+// records before the corrections are generated.
+func CorrectExistingRecords(driver models.DNSProvider, dc *models.DomainConfig, existingRecords models.Records) ([]*models.Correction, []*models.Correction, int, error) {
 	// downcase
 	models.Downcase(existingRecords)
 	models.Downcase(dc.Records)
@@ -25,7 +44,7 @@ func CorrectZoneRecords(driver models.DNSProvider, dc *models.DomainConfig) ([]*
 	// modify the records may. For example, if the provider only
 	// supports certain TTL values, it will adjust the ones in
 	// dc.Records.
-	dc, err = dc.Copy()
+	dc, err := dc.Copy()
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -39,9 +58,56 @@ func CorrectZoneRecords(driver models.DNSProvider, dc *models.DomainConfig) ([]*
 
 	everything, actualChangeCount, err := driver.GetZoneRecordsCorrections(dc, existingRecords)
 	reports, corrections := splitReportsAndCorrections(everything)
+	if err == nil {
+		fillCorrectionChanges(corrections, existingRecords, dc)
+	}
 	return reports, corrections, actualChangeCount, err
 }
 
+// fillCorrectionChanges fills in .Changes for any correction that the
+// provider created without using diff2.Change.CreateCorrection(). It
+// does this by matching each line of the correction's Msg to the
+// messages generated by diff2.ByRecord(). This is best-effort: lines
+// that can't be matched (for example, messages generated by the
+// provider itself) are skipped.
+func fillCorrectionChanges(corrections []*models.Correction, existingRecords models.Records, dc *models.DomainConfig) {
+	needed := false
+	for _, c := range corrections {
+		if len(c.Changes) == 0 {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return
+	}
+
+	changes, _, err := diff2.ByRecord(existingRecords, dc, nil)
+	if err != nil {
+		return
+	}
+	byMsg := map[string]*models.CorrectionChange{}
+	for i := range changes {
+		if changes[i].Type == diff2.REPORT {
+			continue
+		}
+		for _, m := range changes[i].Msgs {
+			byMsg[m] = changes[i].CorrectionChange()
+		}
+	}
+
+	for _, c := range corrections {
+		if len(c.Changes) != 0 {
+			continue
+		}
+		for _, line := range strings.Split(c.Msg, "\n") {
+			if cc, ok := byMsg[line]; ok {
+				c.Changes = append(c.Changes, cc)
+			}
+		}
+	}
+}
+
 func splitReportsAndCorrections(everything []*models.Correction) (reports, corrections []*models.Correction) {
 	for i := range everything {
 		if everything[i].F == nil {